@@ -0,0 +1,65 @@
+// Package http is a small library for making HTTP requests.
+package http
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+// IsTimeout reports whether err, or any error it wraps, is a timeout. An
+// error is a timeout if it is context.DeadlineExceeded or if any error in
+// its chain has a Timeout method reporting true, as net.Error does.
+func IsTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	return hasTimeout(err)
+}
+
+// hasTimeout walks the chain of err, including every branch of joined
+// errors, looking for an error whose Timeout method reports true. It is
+// needed because wrappers such as *url.Error and *net.OpError only check
+// their direct cause in their own Timeout methods.
+func hasTimeout(err error) bool {
+	for err != nil {
+		if t, ok := err.(interface{ Timeout() bool }); ok && t.Timeout() {
+			return true
+		}
+		switch e := err.(type) {
+		case interface{ Unwrap() error }:
+			err = e.Unwrap()
+		case interface{ Unwrap() []error }:
+			for _, inner := range e.Unwrap() {
+				if hasTimeout(inner) {
+					return true
+				}
+			}
+			return false
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// IsDNSError reports whether err, or any error it wraps, is a failure to
+// resolve a host name.
+func IsDNSError(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
+}
+
+// IsConnRefused reports whether err, or any error it wraps, is a refused
+// connection. It always reports false on platforms other than unix and
+// windows.
+func IsConnRefused(err error) bool {
+	return isConnRefused(err)
+}
+
+// IsConnReset reports whether err, or any error it wraps, is a connection
+// reset by the peer. It always reports false on platforms other than unix
+// and windows.
+func IsConnReset(err error) bool {
+	return isConnReset(err)
+}
@@ -0,0 +1,11 @@
+//go:build !unix && !windows
+
+package http
+
+func isConnRefused(err error) bool {
+	return false
+}
+
+func isConnReset(err error) bool {
+	return false
+}
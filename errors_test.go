@@ -0,0 +1,113 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestIsTimeout(t *testing.T) {
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	client := &nethttp.Client{Timeout: 50 * time.Millisecond}
+	_, err := client.Get(server.URL)
+	if err == nil {
+		t.Fatal("expected a client timeout error")
+	}
+	if !IsTimeout(err) {
+		t.Errorf("IsTimeout(%v) = false, want true", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = nethttp.DefaultClient.Do(req)
+	if err == nil {
+		t.Fatal("expected a context deadline error")
+	}
+	if !IsTimeout(err) {
+		t.Errorf("IsTimeout(%v) = false, want true", err)
+	}
+}
+
+func TestIsTimeoutOtherError(t *testing.T) {
+	if IsTimeout(nil) {
+		t.Error("IsTimeout(nil) = true, want false")
+	}
+	if err := errors.New("boom"); IsTimeout(err) {
+		t.Errorf("IsTimeout(%v) = true, want false", err)
+	}
+	if IsTimeout(context.Canceled) {
+		t.Error("IsTimeout(context.Canceled) = true, want false")
+	}
+}
+
+func TestIsTimeoutWrapped(t *testing.T) {
+	wrapped := fmt.Errorf("wrap: %w", os.ErrDeadlineExceeded)
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"url.Error", &url.Error{Op: "Get", URL: "u", Err: wrapped}, true},
+		{"net.OpError", &net.OpError{Op: "dial", Net: "tcp", Err: wrapped}, true},
+		{"joined", errors.Join(errors.New("boom"), wrapped), true},
+		{"url.Error without timeout", &url.Error{Op: "Get", URL: "u", Err: errors.New("boom")}, false},
+	}
+	for _, tt := range tests {
+		if got := IsTimeout(tt.err); got != tt.want {
+			t.Errorf("%s: IsTimeout(%v) = %v, want %v", tt.name, tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestIsDNSError(t *testing.T) {
+	client := &nethttp.Client{Timeout: 5 * time.Second}
+	_, err := client.Get("http://nonexistent.invalid/")
+	if err == nil {
+		t.Fatal("expected a DNS error")
+	}
+	if !IsDNSError(err) {
+		t.Errorf("IsDNSError(%v) = false, want true", err)
+	}
+	if IsConnRefused(err) {
+		t.Errorf("IsConnRefused(%v) = true, want false", err)
+	}
+}
+
+func TestIsConnRefused(t *testing.T) {
+	server := httptest.NewServer(nethttp.HandlerFunc(func(nethttp.ResponseWriter, *nethttp.Request) {}))
+	addr := server.URL
+	server.Close()
+
+	client := &nethttp.Client{Timeout: 5 * time.Second}
+	_, err := client.Get(addr)
+	if err == nil {
+		t.Fatal("expected a connection refused error")
+	}
+	if !IsConnRefused(err) {
+		t.Errorf("IsConnRefused(%v) = false, want true", err)
+	}
+	if IsDNSError(err) {
+		t.Errorf("IsDNSError(%v) = true, want false", err)
+	}
+	if IsConnReset(err) {
+		t.Errorf("IsConnReset(%v) = true, want false", err)
+	}
+}
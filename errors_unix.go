@@ -0,0 +1,16 @@
+//go:build unix
+
+package http
+
+import (
+	"errors"
+	"syscall"
+)
+
+func isConnRefused(err error) bool {
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
+
+func isConnReset(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET)
+}
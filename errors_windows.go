@@ -0,0 +1,19 @@
+package http
+
+import (
+	"errors"
+	"syscall"
+)
+
+// wsaeconnrefused is WSAECONNREFUSED, which the syscall package does not
+// define. Its syscall.ECONNREFUSED is an invented value that winsock never
+// returns.
+const wsaeconnrefused syscall.Errno = 10061
+
+func isConnRefused(err error) bool {
+	return errors.Is(err, wsaeconnrefused)
+}
+
+func isConnReset(err error) bool {
+	return errors.Is(err, syscall.WSAECONNRESET)
+}
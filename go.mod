@@ -0,0 +1,3 @@
+module github.com/rrandall91/http
+
+go 1.21
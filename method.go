@@ -0,0 +1,17 @@
+package http
+
+import nethttp "net/http"
+
+// Common HTTP methods. They are untyped string constants equal to their
+// net/http counterparts, so they can be used anywhere a method string is.
+const (
+	MethodGet     = nethttp.MethodGet
+	MethodHead    = nethttp.MethodHead
+	MethodPost    = nethttp.MethodPost
+	MethodPut     = nethttp.MethodPut
+	MethodPatch   = nethttp.MethodPatch
+	MethodDelete  = nethttp.MethodDelete
+	MethodConnect = nethttp.MethodConnect
+	MethodOptions = nethttp.MethodOptions
+	MethodTrace   = nethttp.MethodTrace
+)
@@ -0,0 +1,35 @@
+package http
+
+import (
+	nethttp "net/http"
+	"testing"
+)
+
+func TestMethodConstants(t *testing.T) {
+	req, err := nethttp.NewRequest(MethodPost, "http://example.com/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Method != "POST" {
+		t.Errorf("Method = %q, want %q", req.Method, "POST")
+	}
+
+	tests := []struct {
+		got, want string
+	}{
+		{MethodGet, "GET"},
+		{MethodHead, "HEAD"},
+		{MethodPost, "POST"},
+		{MethodPut, "PUT"},
+		{MethodPatch, "PATCH"},
+		{MethodDelete, "DELETE"},
+		{MethodConnect, "CONNECT"},
+		{MethodOptions, "OPTIONS"},
+		{MethodTrace, "TRACE"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("method constant = %q, want %q", tt.got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package http
+
+import (
+	"io"
+	nethttp "net/http"
+	"time"
+)
+
+// defaultPingTimeout is used by Ping when it is given a non-positive timeout.
+const defaultPingTimeout = 5 * time.Second
+
+// Ping sends a GET request to target and reports whether it answered with a
+// 2xx status within timeout, along with how long the attempt took. Any error
+// is reported as false. A non-positive timeout is replaced by a default of
+// five seconds, so a probe never waits indefinitely.
+func Ping(target string, timeout time.Duration) (bool, time.Duration) {
+	if timeout <= 0 {
+		timeout = defaultPingTimeout
+	}
+	client := &nethttp.Client{Timeout: timeout}
+
+	start := time.Now()
+	resp, err := client.Get(target)
+	if err != nil {
+		return false, time.Since(start)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	return resp.StatusCode >= 200 && resp.StatusCode < 300, time.Since(start)
+}
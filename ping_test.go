@@ -0,0 +1,74 @@
+package http
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestPingHealthy(t *testing.T) {
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.WriteHeader(nethttp.StatusNoContent)
+	}))
+	defer server.Close()
+
+	ok, elapsed := Ping(server.URL, time.Second)
+	if !ok {
+		t.Error("Ping = false, want true")
+	}
+	if elapsed <= 0 {
+		t.Errorf("elapsed = %v, want > 0", elapsed)
+	}
+}
+
+func TestPingUnhealthyStatus(t *testing.T) {
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.WriteHeader(nethttp.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	if ok, _ := Ping(server.URL, time.Second); ok {
+		t.Error("Ping = true, want false")
+	}
+}
+
+func TestPingClosedPort(t *testing.T) {
+	server := httptest.NewServer(nethttp.HandlerFunc(func(nethttp.ResponseWriter, *nethttp.Request) {}))
+	addr := server.URL
+	server.Close()
+
+	if ok, _ := Ping(addr, time.Second); ok {
+		t.Error("Ping = true, want false")
+	}
+}
+
+func TestPingTimeout(t *testing.T) {
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	timeout := 50 * time.Millisecond
+	ok, elapsed := Ping(server.URL, timeout)
+	if ok {
+		t.Error("Ping = true, want false")
+	}
+	if elapsed < timeout || elapsed > 500*time.Millisecond {
+		t.Errorf("elapsed = %v, want roughly %v", elapsed, timeout)
+	}
+}
+
+func TestPingZeroTimeoutUsesDefault(t *testing.T) {
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.WriteHeader(nethttp.StatusOK)
+	}))
+	defer server.Close()
+
+	if ok, _ := Ping(server.URL, 0); !ok {
+		t.Error("Ping with zero timeout = false, want true")
+	}
+}